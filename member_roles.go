@@ -44,6 +44,108 @@ type MemberRole struct {
 	RemoveProject              bool             `json:"remove_project,omitempty"`
 }
 
+// MemberRoleAbility represents a single permission that can be granted to a
+// custom member role.
+//
+// GitLab API docs: https://docs.gitlab.com/ee/api/member_roles.html
+type MemberRoleAbility string
+
+// List of available member role abilities.
+const (
+	AbilityAdminCICDVariables         MemberRoleAbility = "admin_cicd_variables"
+	AbilityAdminComplianceFramework   MemberRoleAbility = "admin_compliance_framework"
+	AbilityAdminGroupMember           MemberRoleAbility = "admin_group_member"
+	AbilityAdminMergeRequest          MemberRoleAbility = "admin_merge_request"
+	AbilityAdminPushRules             MemberRoleAbility = "admin_push_rules"
+	AbilityAdminTerraformState        MemberRoleAbility = "admin_terraform_state"
+	AbilityAdminVulnerability         MemberRoleAbility = "admin_vulnerability"
+	AbilityAdminWebHook               MemberRoleAbility = "admin_web_hook"
+	AbilityArchiveProject             MemberRoleAbility = "archive_project"
+	AbilityManageDeployTokens         MemberRoleAbility = "manage_deploy_tokens"
+	AbilityManageGroupAccessTokens    MemberRoleAbility = "manage_group_access_tokens"
+	AbilityManageMergeRequestSettings MemberRoleAbility = "manage_merge_request_settings"
+	AbilityManageProjectAccessTokens  MemberRoleAbility = "manage_project_access_tokens"
+	AbilityManageSecurityPolicyLink   MemberRoleAbility = "manage_security_policy_link"
+	AbilityReadCode                   MemberRoleAbility = "read_code"
+	AbilityReadRunners                MemberRoleAbility = "read_runners"
+	AbilityReadDependency             MemberRoleAbility = "read_dependency"
+	AbilityReadVulnerability          MemberRoleAbility = "read_vulnerability"
+	AbilityRemoveGroup                MemberRoleAbility = "remove_group"
+	AbilityRemoveProject              MemberRoleAbility = "remove_project"
+)
+
+// memberRoleAbilityFields centralizes the mapping between a MemberRoleAbility
+// and the corresponding field on MemberRole and CreateMemberRoleOptions, so
+// that EnableAbilities, DisableAbilities and Abilities need no per-ability
+// code. A new GitLab ability still needs its own constant above and its own
+// field on MemberRole, CreateMemberRoleOptions and UpdateMemberRoleOptions
+// besides its entry here.
+var memberRoleAbilityFields = []struct {
+	ability MemberRoleAbility
+	get     func(*MemberRole) bool
+	set     func(*CreateMemberRoleOptions, bool)
+}{
+	{AbilityAdminCICDVariables, func(m *MemberRole) bool { return m.AdminCICDVariables }, func(o *CreateMemberRoleOptions, v bool) { o.AdminCICDVariables = Ptr(v) }},
+	{AbilityAdminComplianceFramework, func(m *MemberRole) bool { return m.AdminComplianceFramework }, func(o *CreateMemberRoleOptions, v bool) { o.AdminComplianceFramework = Ptr(v) }},
+	{AbilityAdminGroupMember, func(m *MemberRole) bool { return m.AdminGroupMembers }, func(o *CreateMemberRoleOptions, v bool) { o.AdminGroupMembers = Ptr(v) }},
+	{AbilityAdminMergeRequest, func(m *MemberRole) bool { return m.AdminMergeRequests }, func(o *CreateMemberRoleOptions, v bool) { o.AdminMergeRequest = Ptr(v) }},
+	{AbilityAdminPushRules, func(m *MemberRole) bool { return m.AdminPushRules }, func(o *CreateMemberRoleOptions, v bool) { o.AdminPushRules = Ptr(v) }},
+	{AbilityAdminTerraformState, func(m *MemberRole) bool { return m.AdminTerraformState }, func(o *CreateMemberRoleOptions, v bool) { o.AdminTerraformState = Ptr(v) }},
+	{AbilityAdminVulnerability, func(m *MemberRole) bool { return m.AdminVulnerability }, func(o *CreateMemberRoleOptions, v bool) { o.AdminVulnerability = Ptr(v) }},
+	{AbilityAdminWebHook, func(m *MemberRole) bool { return m.AdminWebHook }, func(o *CreateMemberRoleOptions, v bool) { o.AdminWebHook = Ptr(v) }},
+	{AbilityArchiveProject, func(m *MemberRole) bool { return m.ArchiveProject }, func(o *CreateMemberRoleOptions, v bool) { o.ArchiveProject = Ptr(v) }},
+	{AbilityManageDeployTokens, func(m *MemberRole) bool { return m.ManageDeployTokens }, func(o *CreateMemberRoleOptions, v bool) { o.ManageDeployTokens = Ptr(v) }},
+	{AbilityManageGroupAccessTokens, func(m *MemberRole) bool { return m.ManageGroupAccesToken }, func(o *CreateMemberRoleOptions, v bool) { o.ManageGroupAccesToken = Ptr(v) }},
+	{AbilityManageMergeRequestSettings, func(m *MemberRole) bool { return m.ManageMergeRequestSettings }, func(o *CreateMemberRoleOptions, v bool) { o.ManageMergeRequestSettings = Ptr(v) }},
+	{AbilityManageProjectAccessTokens, func(m *MemberRole) bool { return m.ManageProjectAccessToken }, func(o *CreateMemberRoleOptions, v bool) { o.ManageProjectAccessToken = Ptr(v) }},
+	{AbilityManageSecurityPolicyLink, func(m *MemberRole) bool { return m.ManageSecurityPolicyLink }, func(o *CreateMemberRoleOptions, v bool) { o.ManageSecurityPolicyLink = Ptr(v) }},
+	{AbilityReadCode, func(m *MemberRole) bool { return m.ReadCode }, func(o *CreateMemberRoleOptions, v bool) { o.ReadCode = Ptr(v) }},
+	{AbilityReadRunners, func(m *MemberRole) bool { return m.ReadRunners }, func(o *CreateMemberRoleOptions, v bool) { o.ReadRunners = Ptr(v) }},
+	{AbilityReadDependency, func(m *MemberRole) bool { return m.ReadDependency }, func(o *CreateMemberRoleOptions, v bool) { o.ReadDependency = Ptr(v) }},
+	{AbilityReadVulnerability, func(m *MemberRole) bool { return m.ReadVulnerability }, func(o *CreateMemberRoleOptions, v bool) { o.ReadVulnerability = Ptr(v) }},
+	{AbilityRemoveGroup, func(m *MemberRole) bool { return m.RemoveGroup }, func(o *CreateMemberRoleOptions, v bool) { o.RemoveGroup = Ptr(v) }},
+	{AbilityRemoveProject, func(m *MemberRole) bool { return m.RemoveProject }, func(o *CreateMemberRoleOptions, v bool) { o.RemoveProject = Ptr(v) }},
+}
+
+// EnableAbilities sets the given abilities to true, leaving all other fields
+// on the options untouched.
+func (opt *CreateMemberRoleOptions) EnableAbilities(abilities ...MemberRoleAbility) *CreateMemberRoleOptions {
+	for _, a := range abilities {
+		for _, f := range memberRoleAbilityFields {
+			if f.ability == a {
+				f.set(opt, true)
+				break
+			}
+		}
+	}
+	return opt
+}
+
+// DisableAbilities sets the given abilities to false, leaving all other
+// fields on the options untouched.
+func (opt *CreateMemberRoleOptions) DisableAbilities(abilities ...MemberRoleAbility) *CreateMemberRoleOptions {
+	for _, a := range abilities {
+		for _, f := range memberRoleAbilityFields {
+			if f.ability == a {
+				f.set(opt, false)
+				break
+			}
+		}
+	}
+	return opt
+}
+
+// Abilities returns the list of abilities enabled on the member role.
+func (m *MemberRole) Abilities() []MemberRoleAbility {
+	abilities := make([]MemberRoleAbility, 0, len(memberRoleAbilityFields))
+	for _, f := range memberRoleAbilityFields {
+		if f.get(m) {
+			abilities = append(abilities, f.ability)
+		}
+	}
+	return abilities
+}
+
 // ListMemberRoles gets a list of member roles for a specified group.
 //
 // Gitlab API docs:
@@ -124,6 +226,86 @@ func (s *MemberRolesService) CreateMemberRole(gid interface{}, opt *CreateMember
 	return mr, resp, nil
 }
 
+// GetMemberRole gets a single member role for a specified group.
+//
+// Gitlab API docs:
+// https://docs.gitlab.com/ee/api/member_roles.html#get-a-member-role-of-a-group
+func (s *MemberRolesService) GetMemberRole(gid interface{}, memberRole int, options ...RequestOptionFunc) (*MemberRole, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/member_roles/%d", PathEscape(group), memberRole)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mr := new(MemberRole)
+	resp, err := s.client.Do(req, mr)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mr, resp, nil
+}
+
+// UpdateMemberRoleOptions represents the available UpdateMemberRole() options.
+//
+// GitLab API docs:
+// https://docs.gitlab.com/ee/api/member_roles.html#update-a-member-role-of-a-group
+type UpdateMemberRoleOptions struct {
+	Name                       *string           `url:"name,omitempty" json:"name,omitempty"`
+	BaseAccessLevel            *AccessLevelValue `url:"base_access_level,omitempty" json:"base_access_level,omitempty"`
+	Description                *string           `url:"description,omitempty" json:"description,omitempty"`
+	AdminCICDVariables         *bool             `url:"admin_cicd_variables,omitempty" json:"admin_cicd_variables,omitempty"`
+	AdminComplianceFramework   *bool             `url:"admin_compliance_framework,omitempty" json:"admin_compliance_framework,omitempty"`
+	AdminGroupMembers          *bool             `url:"admin_group_member,omitempty" json:"admin_group_member,omitempty"`
+	AdminMergeRequest          *bool             `url:"admin_merge_request,omitempty" json:"admin_merge_request,omitempty"`
+	AdminPushRules             *bool             `url:"admin_push_rules,omitempty" json:"admin_push_rules,omitempty"`
+	AdminTerraformState        *bool             `url:"admin_terraform_state,omitempty" json:"admin_terraform_state,omitempty"`
+	AdminVulnerability         *bool             `url:"admin_vulnerability,omitempty" json:"admin_vulnerability,omitempty"`
+	AdminWebHook               *bool             `url:"admin_web_hook,omitempty" json:"admin_web_hook,omitempty"`
+	ArchiveProject             *bool             `url:"archive_project,omitempty" json:"archive_project,omitempty"`
+	ManageDeployTokens         *bool             `url:"manage_deploy_tokens,omitempty" json:"manage_deploy_tokens,omitempty"`
+	ManageGroupAccesToken      *bool             `url:"manage_group_access_tokens,omitempty" json:"manage_group_access_tokens,omitempty"`
+	ManageMergeRequestSettings *bool             `url:"manage_merge_request_settings,omitempty" json:"manage_merge_request_settings,omitempty"`
+	ManageProjectAccessToken   *bool             `url:"manage_project_access_tokens,omitempty" json:"manage_project_access_tokens,omitempty"`
+	ManageSecurityPolicyLink   *bool             `url:"manage_security_policy_link,omitempty" json:"manage_security_policy_link,omitempty"`
+	ReadCode                   *bool             `url:"read_code,omitempty" json:"read_code,omitempty"`
+	ReadRunners                *bool             `url:"read_runners,omitempty" json:"read_runners,omitempty"`
+	ReadDependency             *bool             `url:"read_dependency,omitempty" json:"read_dependency,omitempty"`
+	ReadVulnerability          *bool             `url:"read_vulnerability,omitempty" json:"read_vulnerability,omitempty"`
+	RemoveGroup                *bool             `url:"remove_group,omitempty" json:"remove_group,omitempty"`
+	RemoveProject              *bool             `url:"remove_project,omitempty" json:"remove_project,omitempty"`
+}
+
+// UpdateMemberRole updates a member role for a specified group.
+//
+// Gitlab API docs:
+// https://docs.gitlab.com/ee/api/member_roles.html#update-a-member-role-of-a-group
+func (s *MemberRolesService) UpdateMemberRole(gid interface{}, memberRole int, opt *UpdateMemberRoleOptions, options ...RequestOptionFunc) (*MemberRole, *Response, error) {
+	group, err := parseID(gid)
+	if err != nil {
+		return nil, nil, err
+	}
+	u := fmt.Sprintf("groups/%s/member_roles/%d", PathEscape(group), memberRole)
+
+	req, err := s.client.NewRequest(http.MethodPatch, u, opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mr := new(MemberRole)
+	resp, err := s.client.Do(req, mr)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mr, resp, nil
+}
+
 // DeleteMemberRole deletes a member role from a specified group.
 //
 // Gitlab API docs:
@@ -142,3 +324,81 @@ func (s *MemberRolesService) DeleteMemberRole(gid interface{}, memberRole int, o
 
 	return s.client.Do(req, nil)
 }
+
+// ListInstanceMemberRoles gets a list of instance-level member roles.
+//
+// Gitlab API docs:
+// https://docs.gitlab.com/ee/api/member_roles.html#list-all-instance-member-roles
+func (s *MemberRolesService) ListInstanceMemberRoles(options ...RequestOptionFunc) ([]*MemberRole, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodGet, "member_roles", nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var mrs []*MemberRole
+	resp, err := s.client.Do(req, &mrs)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mrs, resp, nil
+}
+
+// CreateInstanceMemberRole creates a new instance-level member role.
+//
+// The payload shape is identical to CreateMemberRole(), so this reuses
+// CreateMemberRoleOptions (and its EnableAbilities()/DisableAbilities()
+// helpers) rather than duplicating it.
+//
+// Gitlab API docs:
+// https://docs.gitlab.com/ee/api/member_roles.html#add-an-instance-member-role
+func (s *MemberRolesService) CreateInstanceMemberRole(opt *CreateMemberRoleOptions, options ...RequestOptionFunc) (*MemberRole, *Response, error) {
+	req, err := s.client.NewRequest(http.MethodPost, "member_roles", opt, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mr := new(MemberRole)
+	resp, err := s.client.Do(req, mr)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mr, resp, nil
+}
+
+// GetInstanceMemberRole gets a single instance-level member role.
+//
+// Gitlab API docs:
+// https://docs.gitlab.com/ee/api/member_roles.html#get-an-instance-member-role
+func (s *MemberRolesService) GetInstanceMemberRole(memberRole int, options ...RequestOptionFunc) (*MemberRole, *Response, error) {
+	u := fmt.Sprintf("member_roles/%d", memberRole)
+
+	req, err := s.client.NewRequest(http.MethodGet, u, nil, options)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	mr := new(MemberRole)
+	resp, err := s.client.Do(req, mr)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return mr, resp, nil
+}
+
+// DeleteInstanceMemberRole deletes an instance-level member role.
+//
+// Gitlab API docs:
+// https://docs.gitlab.com/ee/api/member_roles.html#remove-an-instance-member-role
+func (s *MemberRolesService) DeleteInstanceMemberRole(memberRole int, options ...RequestOptionFunc) (*Response, error) {
+	u := fmt.Sprintf("member_roles/%d", memberRole)
+
+	req, err := s.client.NewRequest(http.MethodDelete, u, nil, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.Do(req, nil)
+}